@@ -0,0 +1,247 @@
+package businesshours
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// fullDaysOfWeek maps full, case-insensitive weekday names to their 3 letter abbreviation accepted by ParseWeekday.
+var fullDaysOfWeek = map[string]string{
+	"sunday":    "Sun",
+	"monday":    "Mon",
+	"tuesday":   "Tue",
+	"wednesday": "Wed",
+	"thursday":  "Thu",
+	"friday":    "Fri",
+	"saturday":  "Sat",
+}
+
+var (
+	// ampmSpaceRE strips whitespace between a time and a trailing am/pm marker, e.g. "5:30 PM" -> "5:30PM".
+	ampmSpaceRE = regexp.MustCompile(`(?i)(\d)\s*([ap]m)\b`)
+	// timeRangeDashRE collapses whitespace around the dash separating two times, e.g. "9am - 5pm" -> "9am-5pm".
+	timeRangeDashRE = regexp.MustCompile(`(?i)(\d|[ap]m)\s*-\s*(\d)`)
+	// dayListCommaRE strips whitespace following a weekday list comma, e.g. "Mon, Wed, Fri" -> "Mon,Wed,Fri".
+	dayListCommaRE = regexp.MustCompile(`,\s*`)
+	// ampmRE recognises a trailing am/pm marker on a time component.
+	ampmRE = regexp.MustCompile(`(?i)^(\d{1,2})(?::(\d{2}))?([ap]m)$`)
+	// offsetZoneRE recognises a fixed UTC offset, optionally prefixed with "GMT" or "UTC".
+	offsetZoneRE = regexp.MustCompile(`(?i)^(gmt|utc)?([+-])(\d{1,2})(?::?(\d{2}))?$`)
+)
+
+// Schedule is implemented by every business hours representation in this package that can check whether a given
+// time.Time falls inside it.
+type Schedule interface {
+	ContainsTime(t time.Time) bool
+}
+
+// ParseBusinessHoursAny parses a business hours string using a permissive grammar: full weekday names
+// ("Monday-Friday"), lower-case weekdays ("mon-fri"), comma separated weekday lists ("Mon,Wed,Fri 09:00-17:00"),
+// 12-hour times with am/pm ("9am-5pm", "9:30 AM - 5:30 PM"), 24-hour times without a leading zero ("9:00-17:00"),
+// and a location given as an IANA name or a fixed UTC offset ("+02:00", "GMT-5"). A negative offset is only
+// recognised with its "GMT" or "UTC" prefix; a bare "-05:00" is taken as part of the hour range and rejected. It
+// normalises the input and dispatches to ParseBusinessHours when the weekdays form a single contiguous range in
+// Sun..Sat order, or to ParseWeekly when they don't (e.g. "Mon,Wed,Fri", or "Sat-Sun", which wraps around the week),
+// since a *BusinessHours can only ever represent one contiguous range.
+func ParseBusinessHoursAny(in string) (Schedule, error) {
+	norm := ampmSpaceRE.ReplaceAllString(in, "$1$2")
+	norm = timeRangeDashRE.ReplaceAllString(norm, "$1-$2")
+	norm = dayListCommaRE.ReplaceAllString(norm, ",")
+
+	components := strings.Fields(norm)
+	if !(len(components) == 2 || len(components) == 3) {
+		return nil, fmt.Errorf("%w: invalid format %q", ErrorParseBusinessHours, in)
+	}
+
+	days, contiguous, err := resolveWeekdaysAny(components[0])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrorParseBusinessHours, err)
+	}
+
+	hours, err := normalizeHoursAny(components[1])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrorParseBusinessHours, err)
+	}
+
+	var loc *time.Location
+	if len(components) == 3 {
+		if loc, err = parseZoneAny(components[2]); err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrorParseBusinessHours, err)
+		}
+	}
+
+	if contiguous {
+		bh, err := ParseBusinessHours(fmt.Sprintf("%s-%s %s", days[0], days[len(days)-1], hours))
+		if err != nil {
+			return nil, err
+		}
+		if loc != nil {
+			bh.loc = loc
+		}
+		return bh, nil
+	}
+
+	segments := make([]string, len(days))
+	for i, d := range days {
+		segments[i] = fmt.Sprintf("%s %s", d, hours)
+	}
+
+	w, err := ParseWeekly(strings.Join(segments, "; "))
+	if err != nil {
+		return nil, err
+	}
+	if loc != nil {
+		w.loc = loc
+	}
+	return w, nil
+}
+
+// resolveWeekdaysAny resolves a weekday token - a full name, an abbreviation, a "Day-Day" range, or a comma
+// separated list of any of those, in any case - into its sorted, de-duplicated list of Weekday's, plus whether that
+// list forms a single contiguous range in Sun..Sat order (in which case ParseBusinessHours can represent it
+// directly); a range wrapping around the week, such as "Sat-Sun", is not reported as contiguous.
+func resolveWeekdaysAny(in string) ([]Weekday, bool, error) {
+	present := make(map[Weekday]bool)
+
+	for _, group := range strings.Split(in, ",") {
+		bounds := strings.Split(group, "-")
+		if !(len(bounds) == 1 || len(bounds) == 2) {
+			return nil, false, fmt.Errorf("invalid weekday format %q", group)
+		}
+
+		start, err := resolveWeekdayAny(bounds[0])
+		if err != nil {
+			return nil, false, err
+		}
+		end := start
+		if len(bounds) == 2 {
+			if end, err = resolveWeekdayAny(bounds[1]); err != nil {
+				return nil, false, err
+			}
+		}
+
+		for _, d := range expandWeekdays(start, end) {
+			present[d] = true
+		}
+	}
+
+	min, max := Weekday(-1), Weekday(-1)
+	for d := range present {
+		if min == -1 || d < min {
+			min = d
+		}
+		if max == -1 || d > max {
+			max = d
+		}
+	}
+
+	contiguous := len(expandWeekdays(min, max)) == len(present)
+
+	var days []Weekday
+	for d := min; d <= max; d++ {
+		if present[d] {
+			days = append(days, d)
+		}
+	}
+
+	return days, contiguous, nil
+}
+
+// resolveWeekdayAny resolves a single weekday token, accepting full names and abbreviations in any case.
+func resolveWeekdayAny(in string) (Weekday, error) {
+	if abbr, ok := fullDaysOfWeek[strings.ToLower(in)]; ok {
+		return ParseWeekday(abbr)
+	}
+	if len(in) >= 3 {
+		in = strings.ToUpper(in[:1]) + strings.ToLower(in[1:3])
+	}
+	return ParseWeekday(in)
+}
+
+// normalizeHoursAny resolves an hour range token - 12-hour with am/pm, or 24-hour possibly without a leading zero -
+// into the "HH:MM-HH:MM" form ParseBusinessHours expects.
+func normalizeHoursAny(in string) (string, error) {
+	bounds := strings.Split(in, "-")
+	if len(bounds) != 2 {
+		return "", fmt.Errorf("invalid hour range format %q", in)
+	}
+
+	start, err := resolveHourAny(bounds[0])
+	if err != nil {
+		return "", err
+	}
+
+	end, err := resolveHourAny(bounds[1])
+	if err != nil {
+		return "", err
+	}
+
+	return start + "-" + end, nil
+}
+
+// resolveHourAny resolves a single hour token, accepting 12-hour times with a trailing am/pm marker and 24-hour
+// times without a leading zero, into the "HH:MM" form ParseHour expects.
+func resolveHourAny(in string) (string, error) {
+	if m := ampmRE.FindStringSubmatch(in); m != nil {
+		hour, _ := strconv.Atoi(m[1])
+		minute := 0
+		if m[2] != "" {
+			minute, _ = strconv.Atoi(m[2])
+		}
+
+		switch strings.ToLower(m[3]) {
+		case "am":
+			if hour == 12 {
+				hour = 0
+			}
+		case "pm":
+			if hour != 12 {
+				hour += 12
+			}
+		}
+		return fmt.Sprintf("%02d:%02d", hour, minute), nil
+	}
+
+	components := strings.SplitN(in, ":", 2)
+	hour, err := strconv.Atoi(components[0])
+	if err != nil {
+		return "", fmt.Errorf("invalid hour format %q", in)
+	}
+
+	minute := 0
+	if len(components) == 2 {
+		if minute, err = strconv.Atoi(components[1]); err != nil {
+			return "", fmt.Errorf("invalid hour format %q", in)
+		}
+	}
+
+	return fmt.Sprintf("%02d:%02d", hour, minute), nil
+}
+
+// parseZoneAny resolves a location token, accepting an IANA zone name ("Europe/Berlin") or a fixed UTC offset
+// ("+02:00", "GMT-5").
+func parseZoneAny(in string) (*time.Location, error) {
+	if m := offsetZoneRE.FindStringSubmatch(in); m != nil {
+		hours, _ := strconv.Atoi(m[3])
+		minutes := 0
+		if m[4] != "" {
+			minutes, _ = strconv.Atoi(m[4])
+		}
+
+		offset := hours*3600 + minutes*60
+		if m[2] == "-" {
+			offset = -offset
+		}
+
+		return time.FixedZone(fmt.Sprintf("UTC%s%02d:%02d", m[2], hours, minutes), offset), nil
+	}
+
+	loc, err := time.LoadLocation(in)
+	if err != nil {
+		return nil, fmt.Errorf("invalid location %q: %w", in, err)
+	}
+	return loc, nil
+}
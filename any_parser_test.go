@@ -0,0 +1,143 @@
+package businesshours
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseBusinessHoursAny(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		wantStart  Weekday
+		wantEnd    Weekday
+		wantStartH Hour
+		wantEndH   Hour
+	}{{
+		"full weekday names",
+		"Monday-Friday 09:00-17:00",
+		1, 5, 9 * 60, 17 * 60,
+	}, {
+		"lower-case weekdays",
+		"mon-fri 09:00-17:00",
+		1, 5, 9 * 60, 17 * 60,
+	}, {
+		"contiguous comma separated weekday list",
+		"Tue,Wed,Thu 09:00-17:00",
+		2, 4, 9 * 60, 17 * 60,
+	}, {
+		"12-hour times",
+		"Mon-Fri 9am-5pm",
+		1, 5, 9 * 60, 17 * 60,
+	}, {
+		"12-hour times with minutes and spaces",
+		"Mon-Fri 9:30 AM - 5:30 PM",
+		1, 5, 9*60 + 30, 17*60 + 30,
+	}, {
+		"24-hour times without leading zero",
+		"Mon-Fri 9:00-17:00",
+		1, 5, 9 * 60, 17 * 60,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ParseBusinessHoursAny(test.input)
+			if err != nil {
+				t.Fatalf("ParseBusinessHoursAny() returned %v", err)
+			}
+
+			bh, ok := got.(*BusinessHours)
+			if !ok {
+				t.Fatalf("ParseBusinessHoursAny() returned %T, expected *BusinessHours", got)
+			}
+			Assertf(t, bh.startDay == test.wantStart, "got start day %d, expected %d", bh.startDay, test.wantStart)
+			Assertf(t, bh.endDay == test.wantEnd, "got end day %d, expected %d", bh.endDay, test.wantEnd)
+			Assertf(t, bh.startHr == test.wantStartH, "got start hour %d, expected %d", bh.startHr, test.wantStartH)
+			Assertf(t, bh.endHr == test.wantEndH, "got end hour %d, expected %d", bh.endHr, test.wantEndH)
+		})
+	}
+}
+
+func TestParseBusinessHoursAny_NonContiguousWeekdays(t *testing.T) {
+	got, err := ParseBusinessHoursAny("Mon,Wed,Fri 09:00-17:00")
+	if err != nil {
+		t.Fatalf("ParseBusinessHoursAny() returned %v", err)
+	}
+
+	w, ok := got.(*Weekly)
+	if !ok {
+		t.Fatalf("ParseBusinessHoursAny() returned %T, expected *Weekly", got)
+	}
+
+	// INFO: the first weekday of the year 2006 was a sunday
+	Assertf(t, w.ContainsTime(MustParseTime("2006-01-02 10:00")), "expected monday 10:00 to be inside")
+	Assertf(t, !w.ContainsTime(MustParseTime("2006-01-03 10:00")), "expected tuesday 10:00 to be outside")
+	Assertf(t, w.ContainsTime(MustParseTime("2006-01-04 10:00")), "expected wednesday 10:00 to be inside")
+	Assertf(t, !w.ContainsTime(MustParseTime("2006-01-05 10:00")), "expected thursday 10:00 to be outside")
+	Assertf(t, w.ContainsTime(MustParseTime("2006-01-06 10:00")), "expected friday 10:00 to be inside")
+}
+
+func TestParseBusinessHoursAny_Location(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		wantOffset int
+	}{{
+		"IANA location",
+		"Mon-Fri 09:00-17:00 Europe/Berlin",
+		-1, // checked separately below
+	}, {
+		"fixed offset",
+		"Mon-Fri 09:00-17:00 +02:00",
+		2 * 60 * 60,
+	}, {
+		"GMT offset",
+		"Mon-Fri 09:00-17:00 GMT-5",
+		-5 * 60 * 60,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ParseBusinessHoursAny(test.input)
+			if err != nil {
+				t.Fatalf("ParseBusinessHoursAny() returned %v", err)
+			}
+
+			bh, ok := got.(*BusinessHours)
+			if !ok {
+				t.Fatalf("ParseBusinessHoursAny() returned %T, expected *BusinessHours", got)
+			}
+
+			if test.wantOffset == -1 {
+				Assertf(t, bh.loc.String() == "Europe/Berlin", "got location %v, expected Europe/Berlin", bh.loc)
+				return
+			}
+			_, offset := time.Date(2006, 1, 2, 0, 0, 0, 0, bh.loc).Zone()
+			Assertf(t, offset == test.wantOffset, "got offset %d, expected %d", offset, test.wantOffset)
+		})
+	}
+}
+
+func TestParseBusinessHoursAny_NonContiguousWeekdays_Location(t *testing.T) {
+	got, err := ParseBusinessHoursAny("Mon,Wed,Fri 09:00-17:00 Europe/Berlin")
+	if err != nil {
+		t.Fatalf("ParseBusinessHoursAny() returned %v", err)
+	}
+
+	w, ok := got.(*Weekly)
+	if !ok {
+		t.Fatalf("ParseBusinessHoursAny() returned %T, expected *Weekly", got)
+	}
+	Assertf(t, w.loc.String() == "Europe/Berlin", "got location %v, expected Europe/Berlin", w.loc)
+}
+
+func TestParseBusinessHoursAny_ContainsTime(t *testing.T) {
+	got, err := ParseBusinessHoursAny("Mon-Fri 9am-5pm")
+	if err != nil {
+		t.Fatalf("ParseBusinessHoursAny() returned %v", err)
+	}
+
+	// INFO: the first weekday of the year 2006 was a sunday
+	Assertf(t, got.ContainsTime(MustParseTime("2006-01-02 13:00")), "expected monday 13:00 to be inside")
+	Assertf(t, !got.ContainsTime(MustParseTime("2006-01-02 18:00")), "expected monday 18:00 to be outside")
+}
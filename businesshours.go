@@ -1,6 +1,7 @@
 package businesshours
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -204,8 +205,15 @@ func (bh *BusinessHours) MarshalJSON() ([]byte, error) {
 	return json.Marshal(bh.String())
 }
 
-// UnmarshalJSON implements the json.Unmarshaler interface.
+// UnmarshalJSON implements the json.Unmarshaler interface. It accepts both the string form produced by MarshalJSON
+// ("Mon-Fri 09:00-17:00") and the structured object form produced by StructuredHours
+// ({"days":["Mon",...],"start":"09:00","end":"17:00","location":"Europe/Berlin"}).
 func (bh *BusinessHours) UnmarshalJSON(in []byte) error {
+	trimmed := bytes.TrimSpace(in)
+	if len(trimmed) > 0 && trimmed[0] == '{' {
+		return bh.unmarshalStructuredJSON(trimmed)
+	}
+
 	var str string
 	if err := json.Unmarshal(in, &str); err != nil {
 		return err
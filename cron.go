@@ -0,0 +1,185 @@
+package businesshours
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrorParseCron is returned when the input string couldn't be parsed to a valid cron expression.
+var ErrorParseCron = errors.New("couldn't parse cron")
+
+// cronMacros maps the predefined cron macros to their expanded 5-field expression.
+var cronMacros = map[string]string{
+	"@hourly": "0 * * * *",
+	"@daily":  "0 0 * * *",
+	"@weekly": "0 0 * * 0",
+}
+
+// cronWeekdays maps the 3 letter weekday names accepted in the day-of-week field to their Weekday index.
+var cronWeekdays = map[string]int{
+	"SUN": 0,
+	"MON": 1,
+	"TUE": 2,
+	"WED": 3,
+	"THU": 4,
+	"FRI": 5,
+	"SAT": 6,
+}
+
+// CronSchedule describes business hours as a 5-field cron expression restricted to the minute, hour and
+// day-of-week fields. The day-of-month and month fields must be "*". Each field is stored as a bitset, allowing
+// schedules the "Day-Day HH:MM-HH:MM" grammar of BusinessHours cannot express, e.g. every 15 minutes or a lunch
+// break on specific weekdays.
+type CronSchedule struct {
+	minutes  uint64 // bit i set means minute i (0-59) matches
+	hours    uint32 // bit i set means hour i (0-23) matches
+	weekdays uint8  // bit i set means weekday i (Sun = 0, ...) matches
+	loc      *time.Location
+}
+
+// ParseCron converts a 5-field cron expression restricted to the minute, hour and day-of-week fields, e.g.
+// "0-59 9-17 * * MON-FRI", into a CronSchedule. The day-of-month and month fields must be "*". The predefined
+// macros "@daily", "@weekly" and "@hourly" are accepted in place of the full expression. An optional 6th field
+// gives the location the schedule is evaluated in, e.g. "0 9-17 * * MON-FRI Europe/Berlin"; when omitted UTC is
+// assumed.
+func ParseCron(expr string) (*CronSchedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) > 0 {
+		if macro, ok := cronMacros[fields[0]]; ok {
+			fields = append(strings.Fields(macro), fields[1:]...)
+		}
+	}
+
+	if !(len(fields) == 5 || len(fields) == 6) {
+		return nil, fmt.Errorf("%w: expected 5 or 6 fields, got %q", ErrorParseCron, expr)
+	}
+
+	if fields[2] != "*" || fields[3] != "*" {
+		return nil, fmt.Errorf("%w: day-of-month and month fields must be \"*\", got %q", ErrorParseCron, expr)
+	}
+
+	minutes, err := parseCronField(fields[0], 0, 59, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: minute field: %s", ErrorParseCron, err)
+	}
+
+	hours, err := parseCronField(fields[1], 0, 23, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: hour field: %s", ErrorParseCron, err)
+	}
+
+	weekdays, err := parseCronField(fields[4], 0, 6, cronWeekdays)
+	if err != nil {
+		return nil, fmt.Errorf("%w: weekday field: %s", ErrorParseCron, err)
+	}
+
+	loc := time.UTC
+	if len(fields) == 6 {
+		if loc, err = time.LoadLocation(fields[5]); err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrorParseCron, err)
+		}
+	}
+
+	return &CronSchedule{
+		minutes:  minutes,
+		hours:    uint32(hours),
+		weekdays: uint8(weekdays),
+		loc:      loc,
+	}, nil
+}
+
+// ContainsTime checks if a given time.Time is inside the cron schedule.
+func (c *CronSchedule) ContainsTime(t time.Time) bool {
+	tin := t.In(c.loc)
+	minute := tin.Minute()
+	hour := tin.Hour()
+	weekday := int(tin.Weekday())
+
+	return c.minutes&(1<<uint(minute)) != 0 &&
+		c.hours&(1<<uint(hour)) != 0 &&
+		c.weekdays&(1<<uint(weekday)) != 0
+}
+
+// Next returns the next minute, strictly after t, at which the cron schedule matches. It scans forward
+// minute by minute, which is sufficient since the schedule repeats at most weekly.
+func (c *CronSchedule) Next(t time.Time) time.Time {
+	tin := t.In(c.loc).Truncate(time.Minute).Add(time.Minute)
+	for i := 0; i < 7*24*60; i++ {
+		if c.ContainsTime(tin) {
+			return tin
+		}
+		tin = tin.Add(time.Minute)
+	}
+	return time.Time{}
+}
+
+// parseCronField parses a single cron field into a bitset covering [min, max]. A field is a comma separated list of
+// "*", "*/step", "value" or "start-end[/step]" components, where value/start/end may be looked up in names when
+// given (case-insensitively) instead of being a plain integer.
+func parseCronField(in string, min, max int, names map[string]int) (uint64, error) {
+	var bits uint64
+
+	for _, part := range strings.Split(in, ",") {
+		base, step := part, 1
+		if i := strings.IndexByte(part, '/'); i >= 0 {
+			base = part[:i]
+			s, err := strconv.Atoi(part[i+1:])
+			if err != nil || s <= 0 {
+				return 0, fmt.Errorf("invalid step %q", part)
+			}
+			step = s
+		}
+
+		start, end := min, max
+		switch {
+		case base == "*":
+			// full range, already set above.
+		case strings.Contains(base, "-"):
+			bounds := strings.SplitN(base, "-", 2)
+			s, err := parseCronValue(bounds[0], names)
+			if err != nil {
+				return 0, err
+			}
+			e, err := parseCronValue(bounds[1], names)
+			if err != nil {
+				return 0, err
+			}
+			start, end = s, e
+		default:
+			v, err := parseCronValue(base, names)
+			if err != nil {
+				return 0, err
+			}
+			start, end = v, v
+		}
+
+		if start < min || end > max || start > end {
+			return 0, fmt.Errorf("%q out of range [%d, %d]", part, min, max)
+		}
+
+		for v := start; v <= end; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+
+	return bits, nil
+}
+
+// parseCronValue resolves a single cron field value, either a name (looked up case-insensitively in names) or a
+// plain integer.
+func parseCronValue(in string, names map[string]int) (int, error) {
+	if names != nil {
+		if v, ok := names[strings.ToUpper(in)]; ok {
+			return v, nil
+		}
+	}
+
+	v, err := strconv.Atoi(in)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a valid value", in)
+	}
+	return v, nil
+}
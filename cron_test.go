@@ -0,0 +1,127 @@
+package businesshours
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseCron(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr error
+	}{{
+		"minute and hour range with weekday range",
+		"0-59 9-17 * * MON-FRI",
+		nil,
+	}, {
+		"every 15 minutes",
+		"*/15 * * * *",
+		nil,
+	}, {
+		"daily macro",
+		"@daily",
+		nil,
+	}, {
+		"weekly macro",
+		"@weekly",
+		nil,
+	}, {
+		"hourly macro",
+		"@hourly",
+		nil,
+	}, {
+		"with location",
+		"0-59 9-17 * * MON-FRI Europe/Berlin",
+		nil,
+	}, {
+		"macro with location",
+		"@daily Europe/Berlin",
+		nil,
+	}, {
+		"wrong number of fields",
+		"0 9 * *",
+		ErrorParseCron,
+	}, {
+		"day-of-month not wildcard",
+		"0 9 1 * *",
+		ErrorParseCron,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ParseCron(test.input)
+			Assertf(t, errors.Is(err, test.wantErr), "got %v, expected %v", err, test.wantErr)
+			if test.wantErr == nil {
+				Assertf(t, got != nil, "got nil, expected a CronSchedule")
+			}
+		})
+	}
+}
+
+func TestCronSchedule_ContainsTime(t *testing.T) {
+	c, err := ParseCron("0-59 9-17 * * MON-FRI")
+	if err != nil {
+		t.Fatalf("ParseCron() returned %v", err)
+	}
+
+	tests := []struct {
+		name string
+		time time.Time // INFO: the first weekday of the year 2006 was a sunday
+		want bool
+	}{{
+		"inside weekdays 9to5",
+		MustParseTime("2006-01-02 13:00"),
+		true,
+	}, {
+		"day outside weekdays 9to5",
+		MustParseTime("2006-01-01 13:00"),
+		false,
+	}, {
+		"hour outside weekdays 9to5",
+		MustParseTime("2006-01-02 18:00"),
+		false,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := c.ContainsTime(test.time)
+			Assertf(t, got == test.want, "got: %t, want: %t", got, test.want)
+		})
+	}
+}
+
+func TestCronSchedule_ContainsTime_Location(t *testing.T) {
+	// Europe/Berlin is UTC+1 in January (CET, no daylight saving).
+	c, err := ParseCron("0-59 9-17 * * MON-FRI Europe/Berlin")
+	if err != nil {
+		t.Fatalf("ParseCron() returned %v", err)
+	}
+
+	Assertf(t, c.ContainsTime(MustParseTime("2006-01-02 08:00")), "expected 08:00 UTC (09:00 CET) to match")
+	Assertf(t, !c.ContainsTime(MustParseTime("2006-01-02 17:00")), "expected 17:00 UTC (18:00 CET) to not match")
+}
+
+func TestCronSchedule_ContainsTime_Every15Minutes(t *testing.T) {
+	c, err := ParseCron("*/15 * * * *")
+	if err != nil {
+		t.Fatalf("ParseCron() returned %v", err)
+	}
+
+	Assertf(t, c.ContainsTime(MustParseTime("2006-01-02 13:00")), "expected 13:00 to match")
+	Assertf(t, c.ContainsTime(MustParseTime("2006-01-02 13:15")), "expected 13:15 to match")
+	Assertf(t, !c.ContainsTime(MustParseTime("2006-01-02 13:07")), "expected 13:07 to not match")
+}
+
+func TestCronSchedule_Next(t *testing.T) {
+	c, err := ParseCron("0 9 * * MON-FRI")
+	if err != nil {
+		t.Fatalf("ParseCron() returned %v", err)
+	}
+
+	// 2006-01-01 was a sunday, so the next match is monday at 09:00.
+	got := c.Next(MustParseTime("2006-01-01 13:00"))
+	want := MustParseTime("2006-01-02 09:00")
+	Assertf(t, got.Equal(want), "got %v, expected %v", got, want)
+}
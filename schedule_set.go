@@ -0,0 +1,185 @@
+package businesshours
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// holidayKind distinguishes the ways a Holiday can be matched against a time.Time.
+type holidayKind int
+
+const (
+	holidayFixed holidayKind = iota
+	holidayRecurring
+	holidayComputed
+)
+
+// Holiday describes a single calendar exclusion for a ScheduleSet. Dates are matched against the UTC calendar date
+// of the time.Time being checked.
+type Holiday struct {
+	kind  holidayKind
+	date  time.Time
+	month time.Month
+	day   int
+	label string
+	match func(time.Time) bool
+}
+
+// NewFixedHoliday returns a Holiday that matches a single calendar date, e.g. 2024-12-25.
+func NewFixedHoliday(date time.Time) Holiday {
+	y, m, d := date.Date()
+	fixed := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	return Holiday{
+		kind: holidayFixed,
+		date: fixed,
+		match: func(t time.Time) bool {
+			ty, tm, td := t.UTC().Date()
+			return ty == y && tm == m && td == d
+		},
+	}
+}
+
+// NewRecurringHoliday returns a Holiday that matches the given month and day every year, e.g. 12-25.
+func NewRecurringHoliday(month time.Month, day int) Holiday {
+	return Holiday{
+		kind:  holidayRecurring,
+		month: month,
+		day:   day,
+		match: func(t time.Time) bool {
+			tu := t.UTC()
+			return tu.Month() == month && tu.Day() == day
+		},
+	}
+}
+
+// NewComputedHoliday returns a Holiday matched by an arbitrary function, e.g. Easter or an "observed on Monday"
+// rule. label is informational and only used when marshalling to JSON, since fn itself can't be serialised.
+func NewComputedHoliday(label string, fn func(time.Time) bool) Holiday {
+	return Holiday{kind: holidayComputed, label: label, match: fn}
+}
+
+// Matches reports whether t falls on the holiday.
+func (h Holiday) Matches(t time.Time) bool {
+	return h.match(t)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (h Holiday) MarshalJSON() ([]byte, error) {
+	switch h.kind {
+	case holidayFixed:
+		return json.Marshal(struct {
+			Date string `json:"date"`
+		}{h.date.Format("2006-01-02")})
+	case holidayRecurring:
+		return json.Marshal(struct {
+			Recurring string `json:"recurring"`
+		}{fmt.Sprintf("%02d-%02d", int(h.month), h.day)})
+	default:
+		return json.Marshal(struct {
+			Computed string `json:"computed"`
+		}{h.label})
+	}
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. Computed holidays cannot be unmarshalled, since their
+// matching function isn't part of the serialised form.
+func (h *Holiday) UnmarshalJSON(in []byte) error {
+	var raw struct {
+		Date      string `json:"date"`
+		Recurring string `json:"recurring"`
+		Computed  string `json:"computed"`
+	}
+	if err := json.Unmarshal(in, &raw); err != nil {
+		return err
+	}
+
+	switch {
+	case raw.Date != "":
+		date, err := time.Parse("2006-01-02", raw.Date)
+		if err != nil {
+			return fmt.Errorf("couldn't parse holiday date %q: %w", raw.Date, err)
+		}
+		*h = NewFixedHoliday(date)
+	case raw.Recurring != "":
+		date, err := time.Parse("01-02", raw.Recurring)
+		if err != nil {
+			return fmt.Errorf("couldn't parse recurring holiday %q: %w", raw.Recurring, err)
+		}
+		*h = NewRecurringHoliday(date.Month(), date.Day())
+	default:
+		return fmt.Errorf("couldn't unmarshal computed holiday %q: missing matching function", raw.Computed)
+	}
+	return nil
+}
+
+// ScheduleSet composes multiple BusinessHours as inclusions with a list of Holiday exclusions and optional, per-date
+// overrides that replace the hours on a single calendar date, e.g. closing early on Christmas Eve.
+type ScheduleSet struct {
+	inclusions []*BusinessHours
+	holidays   []Holiday
+	overrides  map[string]*BusinessHours
+}
+
+// NewScheduleSet returns a ScheduleSet that is open whenever any of inclusions is.
+func NewScheduleSet(inclusions ...*BusinessHours) *ScheduleSet {
+	return &ScheduleSet{inclusions: inclusions}
+}
+
+// AddHoliday adds a Holiday exclusion to the set.
+func (s *ScheduleSet) AddHoliday(h Holiday) {
+	s.holidays = append(s.holidays, h)
+}
+
+// AddOverride replaces the business hours on date with replacement, e.g. to close early on Christmas Eve.
+func (s *ScheduleSet) AddOverride(date time.Time, replacement *BusinessHours) {
+	if s.overrides == nil {
+		s.overrides = make(map[string]*BusinessHours)
+	}
+	s.overrides[date.UTC().Format("2006-01-02")] = replacement
+}
+
+// ContainsTime checks if a given time.Time is inside the schedule set: at least one inclusion matches, no holiday
+// matches, and any override for that calendar date takes precedence over the inclusions.
+func (s *ScheduleSet) ContainsTime(t time.Time) bool {
+	for _, h := range s.holidays {
+		if h.Matches(t) {
+			return false
+		}
+	}
+
+	if override, ok := s.overrides[t.UTC().Format("2006-01-02")]; ok {
+		return override.ContainsTime(t)
+	}
+
+	for _, bh := range s.inclusions {
+		if bh.ContainsTime(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (s *ScheduleSet) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Hours     []*BusinessHours          `json:"hours"`
+		Holidays  []Holiday                 `json:"holidays,omitempty"`
+		Overrides map[string]*BusinessHours `json:"overrides,omitempty"`
+	}{s.inclusions, s.holidays, s.overrides})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (s *ScheduleSet) UnmarshalJSON(in []byte) error {
+	var raw struct {
+		Hours     []*BusinessHours          `json:"hours"`
+		Holidays  []Holiday                 `json:"holidays,omitempty"`
+		Overrides map[string]*BusinessHours `json:"overrides,omitempty"`
+	}
+	if err := json.Unmarshal(in, &raw); err != nil {
+		return err
+	}
+
+	*s = ScheduleSet{inclusions: raw.Hours, holidays: raw.Holidays, overrides: raw.Overrides}
+	return nil
+}
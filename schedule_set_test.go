@@ -0,0 +1,100 @@
+package businesshours
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestScheduleSet_ContainsTime(t *testing.T) {
+	weekdays9to5 := &BusinessHours{1, 5, 9 * 60, 17 * 60, time.UTC}
+	set := NewScheduleSet(weekdays9to5)
+	set.AddHoliday(NewFixedHoliday(time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC)))
+	set.AddHoliday(NewRecurringHoliday(time.December, 25))
+	set.AddOverride(
+		time.Date(2006, 1, 3, 0, 0, 0, 0, time.UTC),
+		&BusinessHours{2, 2, 9 * 60, 14 * 60, time.UTC},
+	)
+
+	tests := []struct {
+		name string
+		time time.Time // INFO: the first weekday of the year 2006 was a sunday
+		want bool
+	}{{
+		"inside weekdays 9to5",
+		MustParseTime("2006-01-04 10:00"),
+		true,
+	}, {
+		"fixed holiday excludes an otherwise open day",
+		MustParseTime("2006-01-02 10:00"),
+		false,
+	}, {
+		"recurring holiday excludes every year",
+		MustParseTime("2009-12-25 10:00"),
+		false,
+	}, {
+		"override shortens the hours",
+		MustParseTime("2006-01-03 15:00"),
+		false,
+	}, {
+		"override still allows the remaining hours",
+		MustParseTime("2006-01-03 10:00"),
+		true,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := set.ContainsTime(test.time)
+			Assertf(t, got == test.want, "got: %t, want: %t", got, test.want)
+		})
+	}
+}
+
+func TestHoliday_MarshalUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		holiday Holiday
+		want    string
+	}{{
+		"fixed holiday",
+		NewFixedHoliday(time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC)),
+		`{"date":"2024-12-25"}`,
+	}, {
+		"recurring holiday",
+		NewRecurringHoliday(time.December, 25),
+		`{"recurring":"12-25"}`,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := json.Marshal(test.holiday)
+			Assertf(t, errors.Is(err, nil), "got %v, expected nil", err)
+			Assertf(t, string(got) == test.want, "got %q, expected %q", got, test.want)
+
+			var roundtrip Holiday
+			err = json.Unmarshal(got, &roundtrip)
+			Assertf(t, errors.Is(err, nil), "got %v, expected nil", err)
+		})
+	}
+}
+
+func TestHoliday_UnmarshalJSON_Computed(t *testing.T) {
+	var h Holiday
+	err := json.Unmarshal([]byte(`{"computed":"easter"}`), &h)
+	Assertf(t, err != nil, "got nil, expected an error unmarshalling a computed holiday")
+}
+
+func TestScheduleSet_MarshalJSON(t *testing.T) {
+	set := NewScheduleSet(&BusinessHours{1, 5, 9 * 60, 17 * 60, time.UTC})
+	set.AddHoliday(NewRecurringHoliday(time.December, 25))
+
+	got, err := json.Marshal(set)
+	Assertf(t, errors.Is(err, nil), "got %v, expected nil", err)
+
+	var roundtrip ScheduleSet
+	err = json.Unmarshal(got, &roundtrip)
+	Assertf(t, errors.Is(err, nil), "got %v, expected nil", err)
+	Assertf(t, len(roundtrip.inclusions) == 1, "got %d inclusions, expected 1", len(roundtrip.inclusions))
+	Assertf(t, len(roundtrip.holidays) == 1, "got %d holidays, expected 1", len(roundtrip.holidays))
+}
@@ -0,0 +1,112 @@
+package businesshours
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// structuredJSON is the wire format emitted by StructuredHours and accepted by BusinessHours.UnmarshalJSON.
+type structuredJSON struct {
+	Days     []string `json:"days"`
+	Start    string   `json:"start"`
+	End      string   `json:"end"`
+	Location string   `json:"location,omitempty"`
+}
+
+// StructuredHours wraps a *BusinessHours to marshal it as a structured JSON object
+// ({"days":["Mon","Tue",...],"start":"09:00","end":"17:00","location":"Europe/Berlin"}) instead of the default,
+// string-concatenated form. This is useful in config files, where quoting the whole schedule as a single string is
+// inconvenient. BusinessHours.UnmarshalJSON accepts both forms, so a plain *BusinessHours can unmarshal JSON
+// produced by StructuredHours directly.
+type StructuredHours struct {
+	*BusinessHours
+}
+
+// NewStructuredHours wraps bh so that marshalling it to JSON uses the structured object form.
+func NewStructuredHours(bh *BusinessHours) *StructuredHours {
+	return &StructuredHours{bh}
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (s *StructuredHours) MarshalJSON() ([]byte, error) {
+	bh := s.BusinessHours
+
+	location := ""
+	if bh.loc != nil {
+		location = bh.loc.String()
+	}
+
+	days := make([]string, 0, 7)
+	for _, d := range expandWeekdays(bh.startDay, bh.endDay) {
+		days = append(days, d.String())
+	}
+
+	return json.Marshal(structuredJSON{
+		Days:     days,
+		Start:    bh.startHr.String(),
+		End:      bh.endHr.String(),
+		Location: location,
+	})
+}
+
+// unmarshalStructuredJSON decodes the structured object form into bh. The days must form a single contiguous range,
+// since a *BusinessHours cannot represent gaps; use a *Weekly (whose JSON form is a plain schedule string) for a
+// non-contiguous days list such as ["Mon","Wed","Fri"].
+func (bh *BusinessHours) unmarshalStructuredJSON(in []byte) error {
+	var raw structuredJSON
+	if err := json.Unmarshal(in, &raw); err != nil {
+		return err
+	}
+
+	if len(raw.Days) == 0 {
+		return fmt.Errorf("%w: structured form requires at least one day", ErrorParseBusinessHours)
+	}
+
+	startDay, err := ParseWeekday(raw.Days[0])
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrorParseBusinessHours, err)
+	}
+
+	endDay, err := ParseWeekday(raw.Days[len(raw.Days)-1])
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrorParseBusinessHours, err)
+	}
+
+	present := make(map[Weekday]bool, len(raw.Days))
+	for _, d := range raw.Days {
+		day, err := ParseWeekday(d)
+		if err != nil {
+			return fmt.Errorf("%w: %s", ErrorParseBusinessHours, err)
+		}
+		present[day] = true
+	}
+	want := expandWeekdays(startDay, endDay)
+	contiguous := len(want) == len(present)
+	for _, d := range want {
+		contiguous = contiguous && present[d]
+	}
+	if !contiguous {
+		return fmt.Errorf("%w: days %v are not a contiguous range, use a *Weekly instead", ErrorParseBusinessHours, raw.Days)
+	}
+
+	startHr, err := ParseHour(raw.Start)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrorParseBusinessHours, err)
+	}
+
+	endHr, err := ParseHour(raw.End)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrorParseBusinessHours, err)
+	}
+
+	loc := time.UTC
+	if raw.Location != "" {
+		if loc, err = time.LoadLocation(raw.Location); err != nil {
+			return fmt.Errorf("%w: %s", ErrorParseBusinessHours, err)
+		}
+	}
+
+	*bh = BusinessHours{startDay, endDay, startHr, endHr, loc}
+	return nil
+}
@@ -0,0 +1,60 @@
+package businesshours
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestStructuredHours_MarshalJSON(t *testing.T) {
+	bh := &BusinessHours{1, 5, 9 * 60, 17 * 60, locEuropeBerlin}
+	got, err := json.Marshal(NewStructuredHours(bh))
+	want := `{"days":["Mon","Tue","Wed","Thu","Fri"],"start":"09:00","end":"17:00","location":"Europe/Berlin"}`
+
+	Assertf(t, errors.Is(err, nil), "got %v, expected nil", err)
+	Assertf(t, string(got) == want, "got %q, expected %q", got, want)
+}
+
+func TestBusinessHours_UnmarshalJSON_Structured(t *testing.T) {
+	var got BusinessHours
+	in := `{"days":["Mon","Tue","Wed","Thu","Fri"],"start":"09:00","end":"17:00"}`
+	err := json.Unmarshal([]byte(in), &got)
+	want := BusinessHours{1, 5, 9 * 60, 17 * 60, time.UTC}
+
+	Assertf(t, errors.Is(err, nil), "got %v, expected nil", err)
+	Assertf(t, got == want, "got %#v, expected %#v", got, want)
+}
+
+func TestBusinessHours_UnmarshalJSON_StructuredWithLocation(t *testing.T) {
+	var got BusinessHours
+	in := `{"days":["Mon","Tue","Wed","Thu","Fri"],"start":"09:00","end":"17:00","location":"Europe/Berlin"}`
+	err := json.Unmarshal([]byte(in), &got)
+
+	Assertf(t, errors.Is(err, nil), "got %v, expected nil", err)
+	Assertf(t, got.startDay == 1 && got.endDay == 5 && got.startHr == 9*60 && got.endHr == 17*60,
+		"got %#v, expected weekdays 1-5 and hours 09:00-17:00", got)
+	Assertf(t, got.loc.String() == "Europe/Berlin", "got location %v, expected Europe/Berlin", got.loc)
+}
+
+func TestBusinessHours_UnmarshalJSON_StructuredNonContiguousDays(t *testing.T) {
+	var got BusinessHours
+	in := `{"days":["Mon","Wed","Fri"],"start":"09:00","end":"17:00"}`
+	err := json.Unmarshal([]byte(in), &got)
+
+	Assertf(t, errors.Is(err, ErrorParseBusinessHours), "got %v, expected %v", err, ErrorParseBusinessHours)
+}
+
+func TestStructuredHours_RoundTrip(t *testing.T) {
+	bh := &BusinessHours{1, 5, 9 * 60, 17 * 60, time.UTC}
+	data, err := json.Marshal(NewStructuredHours(bh))
+	if err != nil {
+		t.Fatalf("Marshal() returned %v", err)
+	}
+
+	var roundtrip BusinessHours
+	if err := json.Unmarshal(data, &roundtrip); err != nil {
+		t.Fatalf("Unmarshal() returned %v", err)
+	}
+	Assertf(t, roundtrip == *bh, "got %#v, expected %#v", roundtrip, *bh)
+}
@@ -0,0 +1,86 @@
+package businesshours
+
+import (
+	"time"
+)
+
+// Interval describes a half-open span of time, e.g. a single business-open period.
+type Interval struct {
+	Start, End time.Time
+}
+
+// NextStart returns the next time, strictly after t, at which the business hours start. Transitions are computed
+// by constructing the target weekday/hour in bh.loc rather than adding a fixed duration, so that wall-clock time is
+// preserved across daylight saving changes.
+func (bh *BusinessHours) NextStart(t time.Time) time.Time {
+	return bh.nextDailyOccurrence(t, bh.startHr)
+}
+
+// NextEnd returns the next time, strictly after t, at which the business hours end.
+func (bh *BusinessHours) NextEnd(t time.Time) time.Time {
+	return bh.nextDailyOccurrence(t, bh.endHr)
+}
+
+// Until returns the duration until the business hours next open. It returns 0 if t is already inside the business
+// hours.
+func (bh *BusinessHours) Until(t time.Time) time.Duration {
+	if bh.ContainsTime(t) {
+		return 0
+	}
+	return bh.NextStart(t).Sub(t)
+}
+
+// Iterate returns the business-open Interval's that overlap [from, to). If from is itself inside the business
+// hours, the first Interval starts at from rather than at the preceding start.
+func (bh *BusinessHours) Iterate(from, to time.Time) []Interval {
+	var intervals []Interval
+
+	cur := from
+	for cur.Before(to) {
+		start := cur
+		if !bh.ContainsTime(cur) {
+			start = bh.NextStart(cur)
+		}
+		if !start.Before(to) {
+			break
+		}
+
+		end := bh.NextEnd(start)
+		if end.After(to) {
+			end = to
+		}
+
+		intervals = append(intervals, Interval{start, end})
+		cur = end
+	}
+
+	return intervals
+}
+
+// nextDailyOccurrence returns the next time, strictly after t, at which hr occurs on one of the weekdays covered by
+// bh (every weekday between bh.startDay and bh.endDay opens and closes on its own, as ContainsTime implements). hr
+// may exceed 1440 (24:00) to express an event that falls on the day after the weekday it belongs to.
+func (bh *BusinessHours) nextDailyOccurrence(t time.Time, hr Hour) time.Time {
+	anchor := t.In(bh.loc)
+	y, m, d := anchor.Date()
+
+	dayShift := int(hr) / 1440
+	normHr := int(hr) % 1440
+
+	var next time.Time
+	for _, day := range expandWeekdays(bh.startDay, bh.endDay) {
+		targetDay := (int(day) + dayShift) % 7
+		delta := (targetDay - int(anchor.Weekday()) + 7) % 7
+
+		candidate := time.Date(y, m, d+delta, normHr/60, normHr%60, 0, 0, bh.loc)
+		if !candidate.After(t) {
+			candidate = time.Date(y, m, d+delta+7, normHr/60, normHr%60, 0, 0, bh.loc)
+		}
+
+		if next.IsZero() || candidate.Before(next) {
+			next = candidate
+		}
+	}
+
+	return next
+}
@@ -0,0 +1,109 @@
+package businesshours
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBusinessHours_NextStart(t *testing.T) {
+	bh := &BusinessHours{1, 5, 9 * 60, 17 * 60, time.UTC}
+
+	tests := []struct {
+		name string
+		time time.Time // INFO: the first weekday of the year 2006 was a sunday
+		want time.Time
+	}{{
+		"before today's start",
+		MustParseTime("2006-01-02 05:00"),
+		MustParseTime("2006-01-02 09:00"),
+	}, {
+		"after today's start",
+		MustParseTime("2006-01-02 10:00"),
+		MustParseTime("2006-01-03 09:00"),
+	}, {
+		"on the weekend",
+		MustParseTime("2006-01-01 10:00"),
+		MustParseTime("2006-01-02 09:00"),
+	}, {
+		"after the week's last start",
+		MustParseTime("2006-01-06 10:00"),
+		MustParseTime("2006-01-09 09:00"),
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := bh.NextStart(test.time)
+			Assertf(t, got.Equal(test.want), "got %v, expected %v", got, test.want)
+		})
+	}
+}
+
+func TestBusinessHours_NextEnd(t *testing.T) {
+	bh := &BusinessHours{1, 5, 9 * 60, 17 * 60, time.UTC}
+
+	got := bh.NextEnd(MustParseTime("2006-01-02 10:00"))
+	want := MustParseTime("2006-01-02 17:00")
+	Assertf(t, got.Equal(want), "got %v, expected %v", got, want)
+}
+
+func TestBusinessHours_Until(t *testing.T) {
+	bh := &BusinessHours{1, 5, 9 * 60, 17 * 60, time.UTC}
+
+	tests := []struct {
+		name string
+		time time.Time // INFO: the first weekday of the year 2006 was a sunday
+		want time.Duration
+	}{{
+		"inside business hours",
+		MustParseTime("2006-01-02 10:00"),
+		0,
+	}, {
+		"before business hours",
+		MustParseTime("2006-01-02 08:00"),
+		time.Hour,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := bh.Until(test.time)
+			Assertf(t, got == test.want, "got %v, expected %v", got, test.want)
+		})
+	}
+}
+
+func TestBusinessHours_Iterate(t *testing.T) {
+	bh := &BusinessHours{1, 5, 9 * 60, 17 * 60, time.UTC}
+
+	from := MustParseTime("2006-01-02 10:00")
+	to := MustParseTime("2006-01-04 00:00")
+
+	got := bh.Iterate(from, to)
+
+	want := []Interval{
+		{MustParseTime("2006-01-02 10:00"), MustParseTime("2006-01-02 17:00")},
+		{MustParseTime("2006-01-03 09:00"), MustParseTime("2006-01-03 17:00")},
+	}
+
+	Assertf(t, len(got) == len(want), "got %d intervals, expected %d", len(got), len(want))
+	for i := range want {
+		if i >= len(got) {
+			break
+		}
+		Assertf(t, got[i].Start.Equal(want[i].Start) && got[i].End.Equal(want[i].End),
+			"got %v, expected %v", got[i], want[i])
+	}
+}
+
+func TestBusinessHours_NextStart_DST(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("LoadLocation() returned %v", err)
+	}
+
+	// Germany moved clocks forward on 2024-03-31.
+	bh := &BusinessHours{1, 5, 9 * 60, 17 * 60, loc}
+	got := bh.NextStart(time.Date(2024, 3, 29, 10, 0, 0, 0, loc))
+	want := time.Date(2024, 4, 1, 9, 0, 0, 0, loc)
+	Assertf(t, got.Equal(want), "got %v, expected %v", got, want)
+	Assertf(t, got.Hour() == 9, "got hour %d, expected wall-clock 9", got.Hour())
+}
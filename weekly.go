@@ -0,0 +1,233 @@
+package businesshours
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrorParseWeekly is returned when the input string couldn't be parsed to valid weekly hours.
+var ErrorParseWeekly = errors.New("couldn't parse weekly")
+
+// Range describes a single open/close interval within a day. End is expressed in minutes elapsed in the day just
+// like Hour, but may exceed 1440 (24:00) up to 2880 (48:00) to express a range that carries over onto the next day.
+type Range struct {
+	Start, End Hour
+}
+
+// Weekly describes business hours for a specific location where every weekday can carry its own, independent set of
+// open/close ranges, e.g. a lunch break on weekdays and shorter hours on Saturday.
+type Weekly struct {
+	days [7][]Range
+	loc  *time.Location
+}
+
+// ParseWeekly converts a weekly hours string of a format like
+// "Mon 09:00-12:00,13:00-17:00; Tue-Fri 09:00-17:00; Sat 10:00-14:00 Europe/Berlin" into a Weekly. Every segment is
+// separated by ";" and consists of a weekday range followed by a comma separated list of "HH:MM-HH:MM" ranges. The
+// location, if present, is given as the last component of the final segment. When it is omitted UTC is assumed. The
+// single-segment, single-range form accepted by ParseBusinessHours ("Mon-Fri 09:00-17:00") is parseable as well.
+func ParseWeekly(in string) (*Weekly, error) {
+	segments := strings.Split(in, ";")
+
+	w := &Weekly{loc: time.UTC}
+	for i, segment := range segments {
+		segment = strings.TrimSpace(segment)
+		components := strings.Split(segment, " ")
+		if !(len(components) == 2 || (i == len(segments)-1 && len(components) == 3)) {
+			return nil, fmt.Errorf("%w: invalid format %q", ErrorParseWeekly, segment)
+		}
+
+		startDay, endDay, err := parseWeekdays(components[0])
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrorParseWeekly, err)
+		}
+
+		ranges, err := parseRanges(components[1])
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrorParseWeekly, err)
+		}
+
+		if len(components) == 3 {
+			w.loc, err = time.LoadLocation(components[2])
+			if err != nil {
+				return nil, fmt.Errorf("%w: %s", ErrorParseWeekly, err)
+			}
+		}
+
+		for _, day := range expandWeekdays(startDay, endDay) {
+			w.days[day] = ranges
+		}
+	}
+
+	return w, nil
+}
+
+// ContainsTime checks if a given time.Time is inside the weekly hours.
+func (w *Weekly) ContainsTime(t time.Time) bool {
+	tin := t.In(w.loc)
+	day := Weekday(tin.Weekday())
+	hr := Hour(tin.Hour()*60 + tin.Minute())
+
+	for _, r := range w.days[day] {
+		end := r.End
+		if end > 1440 {
+			end = 1440
+		}
+		if r.Start <= hr && hr < end {
+			return true
+		}
+	}
+
+	// check the previous day's ranges for a carry-over onto today.
+	prev := Weekday((int(day) + 6) % 7)
+	for _, r := range w.days[prev] {
+		if r.End > 1440 && hr < r.End-1440 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// String implements the fmt.Stringer interface.
+func (w *Weekly) String() string {
+	var segments []string
+
+	start := 0
+	for start < 7 {
+		end := start
+		for end+1 < 7 && sameRanges(w.days[end+1], w.days[start]) {
+			end++
+		}
+
+		if len(w.days[start]) > 0 {
+			weekdays := fmt.Sprintf("%s-%s", Weekday(start), Weekday(end))
+			if start == end {
+				weekdays = Weekday(start).String()
+			}
+			segments = append(segments, fmt.Sprintf("%s %s", weekdays, rangesString(w.days[start])))
+		}
+
+		start = end + 1
+	}
+
+	out := strings.Join(segments, "; ")
+	if w.loc != nil {
+		out = fmt.Sprintf("%s %s", out, w.loc)
+	}
+	return out
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (w *Weekly) MarshalJSON() ([]byte, error) {
+	return json.Marshal(w.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (w *Weekly) UnmarshalJSON(in []byte) error {
+	var str string
+	if err := json.Unmarshal(in, &str); err != nil {
+		return err
+	}
+
+	weekly, err := ParseWeekly(str)
+	if err != nil {
+		return err
+	}
+	*w = *weekly
+	return nil
+}
+
+// expandWeekdays returns the list of Weekday between start and end (inclusive), wrapping around the week when end is
+// before start.
+func expandWeekdays(start, end Weekday) []Weekday {
+	days := []Weekday{start}
+	for d := start; d%7 != end; d++ {
+		days = append(days, (d+1)%7)
+	}
+	return days
+}
+
+// sameRanges reports whether a and b describe the same ranges in the same order.
+func sameRanges(a, b []Range) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// rangesString renders a list of Range as a comma separated "HH:MM-HH:MM" list.
+func rangesString(ranges []Range) string {
+	out := make([]string, len(ranges))
+	for i, r := range ranges {
+		out[i] = fmt.Sprintf("%s-%s", r.Start, extHourString(r.End))
+	}
+	return strings.Join(out, ",")
+}
+
+// parseRanges parses a comma separated list of "HH:MM-HH:MM" ranges.
+func parseRanges(in string) ([]Range, error) {
+	parts := strings.Split(in, ",")
+	ranges := make([]Range, len(parts))
+	for i, part := range parts {
+		hours := strings.Split(part, "-")
+		if len(hours) != 2 {
+			return nil, fmt.Errorf("invalid hour range format %q", part)
+		}
+
+		start, err := ParseHour(hours[0])
+		if err != nil {
+			return nil, err
+		}
+
+		end, err := parseExtHour(hours[1])
+		if err != nil {
+			return nil, err
+		}
+
+		ranges[i] = Range{start, end}
+	}
+	return ranges, nil
+}
+
+// parseExtHour converts an hour string of the format "HH:MM" into the number of minutes elapsed in the day, allowing
+// values up to "48:00" to express a range carrying over onto the next day.
+func parseExtHour(in string) (Hour, error) {
+	components := strings.Split(in, ":")
+	if len(components) != 2 {
+		return 0, fmt.Errorf("%w: %q invalid format", ErrorParseHour, in)
+	}
+
+	hours, err := strconv.Atoi(components[0])
+	if err != nil {
+		return 0, fmt.Errorf("%w: converting hour %q", ErrorParseHour, in)
+	}
+
+	minutes, err := strconv.Atoi(components[1])
+	if err != nil {
+		return 0, fmt.Errorf("%w: converting minute %q", ErrorParseHour, in)
+	}
+
+	if hours < 0 || hours > 48 || minutes < 0 || minutes > 59 || (hours == 48 && minutes > 0) {
+		return 0, fmt.Errorf("%w: hour %q out of range", ErrorParseHour, in)
+	}
+
+	return Hour(hours*60 + minutes), nil
+}
+
+// extHourString renders an Hour that may exceed 1440 (24:00) up to 2880 (48:00).
+func extHourString(h Hour) string {
+	if h <= 1440 {
+		return h.String()
+	}
+	return fmt.Sprintf("%02d:%02d", int(h/60), int(h%60))
+}
@@ -0,0 +1,149 @@
+package businesshours
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseWeekly(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr error
+	}{{
+		"single segment single range",
+		"Mon-Fri 09:00-17:00",
+		nil,
+	}, {
+		"multiple ranges per day",
+		"Mon 09:00-12:00,13:00-17:00; Tue-Fri 09:00-17:00; Sat 10:00-14:00",
+		nil,
+	}, {
+		"with location",
+		"Mon-Fri 09:00-17:00 Europe/Berlin",
+		nil,
+	}, {
+		"overnight carry-over",
+		"Fri 22:00-30:00",
+		nil,
+	}, {
+		"invalid format",
+		"invalid format",
+		ErrorParseWeekly,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ParseWeekly(test.input)
+			Assertf(t, errors.Is(err, test.wantErr), "got %v, expected %v", err, test.wantErr)
+			if test.wantErr == nil {
+				Assertf(t, got != nil, "got nil, expected a Weekly")
+			}
+		})
+	}
+}
+
+func TestWeekly_ContainsTime(t *testing.T) {
+	w, err := ParseWeekly("Mon 09:00-12:00,13:00-17:00; Tue-Fri 09:00-17:00; Sat 10:00-14:00")
+	if err != nil {
+		t.Fatalf("ParseWeekly() returned %v", err)
+	}
+
+	tests := []struct {
+		name string
+		time time.Time // INFO: the first weekday of the year 2006 was a sunday
+		want bool
+	}{{
+		"inside monday morning",
+		MustParseTime("2006-01-02 10:00"),
+		true,
+	}, {
+		"inside monday lunch break",
+		MustParseTime("2006-01-02 12:30"),
+		false,
+	}, {
+		"inside monday afternoon",
+		MustParseTime("2006-01-02 14:00"),
+		true,
+	}, {
+		"inside tuesday",
+		MustParseTime("2006-01-03 10:00"),
+		true,
+	}, {
+		"inside saturday",
+		MustParseTime("2006-01-07 11:00"),
+		true,
+	}, {
+		"outside sunday",
+		MustParseTime("2006-01-01 11:00"),
+		false,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := w.ContainsTime(test.time)
+			Assertf(t, got == test.want, "got: %t, want: %t", got, test.want)
+		})
+	}
+}
+
+func TestWeekly_ContainsTime_Overnight(t *testing.T) {
+	w, err := ParseWeekly("Fri 22:00-30:00")
+	if err != nil {
+		t.Fatalf("ParseWeekly() returned %v", err)
+	}
+
+	tests := []struct {
+		name string
+		time time.Time // INFO: the first weekday of the year 2006 was a sunday
+		want bool
+	}{{
+		"friday night",
+		MustParseTime("2006-01-06 23:00"),
+		true,
+	}, {
+		"saturday early morning carry-over",
+		MustParseTime("2006-01-07 05:00"),
+		true,
+	}, {
+		"saturday morning after carry-over",
+		MustParseTime("2006-01-07 07:00"),
+		false,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := w.ContainsTime(test.time)
+			Assertf(t, got == test.want, "got: %t, want: %t", got, test.want)
+		})
+	}
+}
+
+func TestWeekly_String(t *testing.T) {
+	w, err := ParseWeekly("Mon-Fri 09:00-17:00")
+	if err != nil {
+		t.Fatalf("ParseWeekly() returned %v", err)
+	}
+
+	want := "Mon-Fri 09:00-17:00 UTC"
+	got := w.String()
+	Assertf(t, got == want, "got %q, expected %q", got, want)
+}
+
+func TestWeekly_MarshalUnmarshalJSON(t *testing.T) {
+	in := "Mon-Fri 09:00-17:00 UTC"
+	w, err := ParseWeekly(in)
+	if err != nil {
+		t.Fatalf("ParseWeekly() returned %v", err)
+	}
+
+	got, err := json.Marshal(w)
+	Assertf(t, errors.Is(err, nil), "got %v, expected nil", err)
+
+	var roundtrip Weekly
+	err = json.Unmarshal(got, &roundtrip)
+	Assertf(t, errors.Is(err, nil), "got %v, expected nil", err)
+	Assertf(t, roundtrip.String() == w.String(), "got %q, expected %q", roundtrip.String(), w.String())
+}
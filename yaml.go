@@ -0,0 +1,23 @@
+package businesshours
+
+import "gopkg.in/yaml.v3"
+
+// MarshalYAML implements the yaml.Marshaler interface.
+func (bh *BusinessHours) MarshalYAML() (interface{}, error) {
+	return bh.String(), nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (bh *BusinessHours) UnmarshalYAML(node *yaml.Node) error {
+	var str string
+	if err := node.Decode(&str); err != nil {
+		return err
+	}
+
+	businesshours, err := ParseBusinessHours(str)
+	if err != nil {
+		return err
+	}
+	*bh = *businesshours
+	return nil
+}
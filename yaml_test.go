@@ -0,0 +1,28 @@
+package businesshours
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestBusinessHours_MarshalYAML(t *testing.T) {
+	bh := &BusinessHours{1, 5, 9 * 60, 17 * 60, time.UTC}
+
+	got, err := yaml.Marshal(bh)
+	want := "Mon-Fri 09:00-17:00 UTC\n"
+
+	Assertf(t, errors.Is(err, nil), "got %v, expected nil", err)
+	Assertf(t, string(got) == want, "got %q, expected %q", got, want)
+}
+
+func TestBusinessHours_UnmarshalYAML(t *testing.T) {
+	var got BusinessHours
+	err := yaml.Unmarshal([]byte("Mon-Fri 09:00-17:00\n"), &got)
+	want := BusinessHours{1, 5, 9 * 60, 17 * 60, time.UTC}
+
+	Assertf(t, errors.Is(err, nil), "got %v, expected nil", err)
+	Assertf(t, got == want, "got %#v, expected %#v", got, want)
+}